@@ -74,10 +74,22 @@ func (w *Pool) makeCrawler(ctx context.Context) error {
 }
 
 func (w *Pool) getSearchClient() (*elasticsearch.Client, error) {
+	var compress bool
+
+	switch w.config.ElasticSearch.RequestCompression {
+	case "none":
+		compress = false
+	case "gzip":
+		compress = true
+	default:
+		return nil, fmt.Errorf("invalid elasticsearch.request_compression %q", w.config.ElasticSearch.RequestCompression)
+	}
+
 	clientConfig := &elasticsearch.ClientConfig{
-		URL:       w.config.ElasticSearch.URL,
-		Transport: utils.GetHTTPTransport(w.dialer.DialContext, 10),
-		Debug:     false,
+		URL:                 w.config.ElasticSearch.URL,
+		Transport:           utils.GetHTTPTransport(w.dialer.DialContext, 10),
+		Debug:               false,
+		CompressRequestBody: compress,
 	}
 
 	return elasticsearch.NewClient(clientConfig, w.Instrumentation)
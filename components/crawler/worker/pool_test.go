@@ -0,0 +1,36 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/ipfs-search/ipfs-search/config"
+	"github.com/ipfs-search/ipfs-search/instr"
+	"github.com/ipfs-search/ipfs-search/utils"
+)
+
+func testPool(requestCompression string) *Pool {
+	cfg := config.Default()
+	cfg.ElasticSearch.RequestCompression = requestCompression
+
+	return &Pool{
+		config:          cfg,
+		dialer:          &utils.RetryingDialer{},
+		Instrumentation: instr.New(),
+	}
+}
+
+func TestGetSearchClientRequestCompression(t *testing.T) {
+	for _, v := range []string{"none", "gzip"} {
+		if _, err := testPool(v).getSearchClient(); err != nil {
+			t.Fatalf("unexpected error for request_compression %q: %v", v, err)
+		}
+	}
+}
+
+func TestGetSearchClientInvalidRequestCompression(t *testing.T) {
+	for _, v := range []string{"true", "GZIP", "gzip ", ""} {
+		if _, err := testPool(v).getSearchClient(); err == nil {
+			t.Fatalf("expected error for request_compression %q", v)
+		}
+	}
+}
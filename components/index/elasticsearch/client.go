@@ -24,11 +24,12 @@ type Client struct {
 	*instr.Instrumentation
 }
 
-// ClientConfig configures search index.
+// ClientConfig configures search index. CompressRequestBody gzips request bodies.
 type ClientConfig struct {
-	URL       string
-	Transport http.RoundTripper
-	Debug     bool
+	URL                 string
+	Transport           http.RoundTripper
+	Debug               bool
+	CompressRequestBody bool
 }
 
 // NewClient returns a configured search index, or an error.
@@ -83,6 +84,7 @@ func getSearchClient(cfg *ClientConfig, i *instr.Instrumentation) (*opensearch.C
 		RetryOnStatus:        []int{429, 502, 503, 504},
 		EnableRetryOnTimeout: true,
 		RetryBackoff:         func(i int) time.Duration { return b.ForAttempt(float64(i)) },
+		CompressRequestBody:  cfg.CompressRequestBody,
 	}
 
 	if cfg.Debug {
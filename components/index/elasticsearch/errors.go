@@ -0,0 +1,17 @@
+package elasticsearch
+
+import (
+	"errors"
+)
+
+var (
+	// ErrUnexpectedRedirect is returned when the backend responds with a redirect, which typically
+	// indicates a misconfigured URL.
+	ErrUnexpectedRedirect = errors.New("unexpected redirect from backend")
+
+	// ErrReadBlocked is returned when the index or cluster blocks reads, e.g. during maintenance.
+	ErrReadBlocked = errors.New("read blocked by backend")
+
+	// ErrNonPointerDestination is returned when the destination to decode into is not a non-nil pointer.
+	ErrNonPointerDestination = errors.New("destination is not a non-nil pointer")
+)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"reflect"
 
 	opensearchapi "github.com/opensearch-project/opensearch-go/opensearchapi"
 	opensearchutil "github.com/opensearch-project/opensearch-go/opensearchutil"
@@ -128,14 +129,64 @@ func (i *Index) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// maxSnippetLength is the maximum number of bytes of a response body included in errors.
+const maxSnippetLength = 256
+
+// bodySnippet returns the start of body for inclusion in error messages.
+func bodySnippet(body []byte) string {
+	if len(body) > maxSnippetLength {
+		return fmt.Sprintf("%q...", body[:maxSnippetLength])
+	}
+
+	return fmt.Sprintf("%q", body)
+}
+
+// maxErrorBodyLength is the maximum number of bytes read from the body of error responses.
+const maxErrorBodyLength = 64 * 1024
+
+// statusError returns an error describing an unexpected response status.
+func statusError(res *opensearchapi.Response) error {
+	if res.StatusCode >= 300 && res.StatusCode < 400 {
+		return fmt.Errorf("%w: %s to '%s'", ErrUnexpectedRedirect, res.Status(), res.Header.Get("Location"))
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodyLength))
+
+	response := struct {
+		Error struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	}{}
+
+	// Bodies which are not JSON, e.g. from proxies, leave the error type empty.
+	_ = json.Unmarshal(body, &response)
+
+	if response.Error.Type == "cluster_block_exception" {
+		return fmt.Errorf("%w: %s", ErrReadBlocked, response.Error.Reason)
+	}
+
+	return fmt.Errorf("unexpected status from backend: %s, body: %s", res.Status(), bodySnippet(body))
+}
+
 // Get retreives `fields` from document with `id` from the index, returning:
 // - (true, decoding_error) if found (decoding error set when errors in json)
 // - (false, nil) when not found
 // - (false, error) otherwise
+// dst should be a non-nil pointer, ErrNonPointerDestination is returned otherwise.
+// When no fields are given, the full document source is returned. Fields may contain
+// wildcard patterns such as `obj.*`, which are passed to the backend unaltered.
 func (i *Index) Get(ctx context.Context, id string, dst interface{}, fields ...string) (bool, error) {
 	ctx, span := i.c.Tracer.Start(ctx, "index.elasticsearch.Get")
 	defer span.End()
 
+	// Fail early, as decoding into a non-pointer would silently discard the source.
+	if v := reflect.ValueOf(dst); v.Kind() != reflect.Ptr || v.IsNil() {
+		err := fmt.Errorf("%w: %T", ErrNonPointerDestination, dst)
+		span.RecordError(ctx, err, trace.WithErrorStatus(codes.Error))
+		return false, err
+	}
+
 	req := opensearchapi.GetRequest{
 		Index:          i.cfg.Name,
 		DocumentID:     id,
@@ -163,16 +214,22 @@ func (i *Index) Get(ctx context.Context, id string, dst interface{}, fields ...s
 			Source json.RawMessage `json:"_source"`
 		}{}
 
-		decoder := json.NewDecoder(res.Body)
-		err = decoder.Decode(&response)
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			err = fmt.Errorf("error reading body: %w", err)
+			span.RecordError(ctx, err, trace.WithErrorStatus(codes.Error))
+			return false, err
+		}
+
+		err = json.Unmarshal(body, &response)
 		if err != nil {
-			err = fmt.Errorf("error decoding body: %w", err)
+			err = fmt.Errorf("error decoding body: %w, body: %s", err, bodySnippet(body))
 			span.RecordError(ctx, err, trace.WithErrorStatus(codes.Error))
 			return false, err
 		}
 
 		// Decode source into destination
-		err = json.Unmarshal(response.Source, &dst)
+		err = json.Unmarshal(response.Source, dst)
 		if err != nil {
 			err = fmt.Errorf("error decoding source: %w", err)
 			span.RecordError(ctx, err, trace.WithErrorStatus(codes.Error))
@@ -184,7 +241,7 @@ func (i *Index) Get(ctx context.Context, id string, dst interface{}, fields ...s
 		// Not found
 		return false, nil
 	default:
-		err = fmt.Errorf("unexpected status from backend: %s", res.Status())
+		err = statusError(res)
 		span.RecordError(ctx, err, trace.WithErrorStatus(codes.Error))
 		return false, err
 	}
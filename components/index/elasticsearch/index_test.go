@@ -3,8 +3,11 @@ package elasticsearch
 // TODO: Test whether indexed items with omitempty are actually left out - otherwise
 // non-updating references will overwrite the existing!
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"testing"
 
@@ -51,6 +54,16 @@ func (s *IndexTestSuite) expectHelloWorld() {
 
 }
 
+// headerRecorder is a RoundTripper recording request headers by path.
+type headerRecorder struct {
+	headers map[string]http.Header
+}
+
+func (r *headerRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.headers[req.URL.Path] = req.Header.Clone()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
 func (s *IndexTestSuite) SetupTest() {
 	s.ctx = context.Background()
 	s.instr = instr.New()
@@ -88,8 +101,10 @@ func (s *IndexTestSuite) TestString() {
 	s.Equal(fmt.Sprintf("%s", idx), "test")
 }
 
-func (s *IndexTestSuite) TestIndex() {
-	idx := New(s.mockClient, &Config{Name: "test"})
+// indexCreate indexes a test document through client, expecting a bulk request whose body
+// is matched by the argument returned from match for the expected NDJSON.
+func (s *IndexTestSuite) indexCreate(client *Client, match func(request []byte) interface{}) {
+	idx := New(client, &Config{Name: "test"})
 
 	// Note whitespace here! This is NDJSON
 	request := []byte(`{"create":{"_id":"objId","_index":"test"}}
@@ -132,7 +147,7 @@ func (s *IndexTestSuite) TestIndex() {
 
 	testURL := "/_bulk"
 	s.mockAPIHandler.
-		On("Handle", "POST", testURL, request).
+		On("Handle", "POST", testURL, match(request)).
 		Return(httpmock.Response{
 			Body: response,
 		}).
@@ -142,12 +157,45 @@ func (s *IndexTestSuite) TestIndex() {
 	s.NoError(err)
 
 	// Ensure flushing
-	err = s.mockClient.Close(s.ctx)
+	err = client.Close(s.ctx)
 	s.NoError(err)
 
 	s.mockAPIHandler.AssertExpectations(s.T())
 }
 
+func (s *IndexTestSuite) TestIndex() {
+	s.indexCreate(s.mockClient, func(request []byte) interface{} {
+		return request
+	})
+}
+
+func (s *IndexTestSuite) TestIndexCompressed() {
+	recorder := &headerRecorder{headers: make(map[string]http.Header)}
+	config := &ClientConfig{
+		URL:                 s.mockAPIServer.URL(),
+		Transport:           recorder,
+		Debug:               true,
+		CompressRequestBody: true,
+	}
+	client, err := NewClient(config, s.instr)
+	s.NoError(err)
+
+	// The mock receives the raw body, which should decompress to the expected NDJSON.
+	s.indexCreate(client, func(request []byte) interface{} {
+		return mock.MatchedBy(func(body []byte) bool {
+			zr, err := gzip.NewReader(bytes.NewReader(body))
+			if err != nil {
+				return false
+			}
+
+			decompressed, err := io.ReadAll(zr)
+			return err == nil && bytes.Equal(decompressed, request)
+		})
+	})
+
+	s.Equal("gzip", recorder.headers["/_bulk"].Get("Content-Encoding"))
+}
+
 func (s *IndexTestSuite) TestUpdate() {
 	idx := New(s.mockClient, &Config{Name: "test"})
 
@@ -393,6 +441,231 @@ func (s *IndexTestSuite) TestGetNotFound() {
 	s.mockAPIHandler.AssertExpectations(s.T())
 }
 
+func (s *IndexTestSuite) TestGetAllFields() {
+	idx := New(s.mockClient, &Config{Name: "test"})
+
+	testFound := []byte(`{
+		"_index": "test",
+		"_type": "_doc",
+		"_id": "objId",
+		"_version": 1,
+		"_seq_no": 0,
+		"_primary_term": 1,
+		"found": true,
+		"_source": {
+   		"field1": "value",
+   		"field2": 5
+		}
+	}`)
+
+	// No fields requested: _source_includes should be omitted to return the full document.
+	testURL := "/test/_doc/objId?preference=_local&realtime=true"
+	s.mockAPIHandler.
+		On("Handle", "GET", testURL, mock.Anything).
+		Return(httpmock.Response{
+			Body: testFound,
+		}).
+		Once()
+
+	type testType struct {
+		Field1 string `json:"field1"`
+		Field2 int    `json:"field2"`
+	}
+
+	dst := testType{}
+
+	result, err := idx.Get(s.ctx, "objId", &dst)
+	s.NoError(err)
+	s.True(result)
+	s.Equal(dst, testType{
+		Field1: "value",
+		Field2: 5,
+	})
+
+	s.mockAPIHandler.AssertExpectations(s.T())
+}
+
+func (s *IndexTestSuite) TestGetWildcardFields() {
+	idx := New(s.mockClient, &Config{Name: "test"})
+
+	testFound := []byte(`{
+		"_index": "test",
+		"_type": "_doc",
+		"_id": "objId",
+		"_version": 1,
+		"_seq_no": 0,
+		"_primary_term": 1,
+		"found": true,
+		"_source": {
+   		"field": {
+   			"a": "value"
+   		}
+		}
+	}`)
+
+	// Wildcards are only query-escaped, the patterns themselves are passed as-is.
+	testURL := "/test/_doc/objId?_source_includes=%2A%2Cfield.%2A%2Cobj.nested.%2A&preference=_local&realtime=true"
+	s.mockAPIHandler.
+		On("Handle", "GET", testURL, mock.Anything).
+		Return(httpmock.Response{
+			Body: testFound,
+		}).
+		Once()
+
+	type testType struct {
+		Field map[string]string `json:"field"`
+	}
+
+	dst := testType{}
+
+	result, err := idx.Get(s.ctx, "objId", &dst, "*", "field.*", "obj.nested.*")
+	s.NoError(err)
+	s.True(result)
+	s.Equal(dst, testType{
+		Field: map[string]string{"a": "value"},
+	})
+
+	s.mockAPIHandler.AssertExpectations(s.T())
+}
+
+func (s *IndexTestSuite) TestGetMalformedBody() {
+	idx := New(s.mockClient, &Config{Name: "test"})
+
+	testHTML := []byte(`<html><body><h1>Proxy error</h1></body></html>`)
+
+	testURL := "/test/_doc/objId?preference=_local&realtime=true"
+	s.mockAPIHandler.
+		On("Handle", "GET", testURL, mock.Anything).
+		Return(httpmock.Response{
+			Body: testHTML,
+		}).
+		Once()
+
+	dst := struct{}{}
+
+	result, err := idx.Get(s.ctx, "objId", &dst)
+	s.Error(err)
+	s.False(result)
+	s.Contains(err.Error(), "error decoding body")
+	s.Contains(err.Error(), "Proxy error")
+
+	s.mockAPIHandler.AssertExpectations(s.T())
+}
+
+func (s *IndexTestSuite) TestGetRedirect() {
+	idx := New(s.mockClient, &Config{Name: "test"})
+
+	testURL := "/test/_doc/objId?preference=_local&realtime=true"
+	s.mockAPIHandler.
+		On("Handle", "GET", testURL, mock.Anything).
+		Return(httpmock.Response{
+			Status: 302,
+			Header: http.Header{
+				"Location": []string{"https://elsewhere.example/test/_doc/objId"},
+			},
+		}).
+		Once()
+
+	dst := struct{}{}
+
+	result, err := idx.Get(s.ctx, "objId", &dst)
+	s.ErrorIs(err, ErrUnexpectedRedirect)
+	s.False(result)
+	s.Contains(err.Error(), "https://elsewhere.example/test/_doc/objId")
+
+	s.mockAPIHandler.AssertExpectations(s.T())
+}
+
+func (s *IndexTestSuite) TestGetGatewayTimeout() {
+	idx := New(s.mockClient, &Config{Name: "test"})
+
+	testHTML := []byte(`<html><head><title>504 Gateway Time-out</title></head></html>`)
+
+	testURL := "/test/_doc/objId?preference=_local&realtime=true"
+	s.mockAPIHandler.
+		On("Handle", "GET", testURL, mock.Anything).
+		Return(httpmock.Response{
+			Status: 504,
+			Body:   testHTML,
+		}).
+		Once()
+
+	dst := struct{}{}
+
+	result, err := idx.Get(s.ctx, "objId", &dst)
+	s.Error(err)
+	s.False(result)
+	s.Contains(err.Error(), "504 Gateway Timeout")
+	s.Contains(err.Error(), "<title>504 Gateway Time-out</title>")
+
+	s.mockAPIHandler.AssertExpectations(s.T())
+}
+
+func (s *IndexTestSuite) TestGetReadBlocked() {
+	idx := New(s.mockClient, &Config{Name: "test"})
+
+	testBlocked := []byte(`{
+		"error": {
+			"root_cause": [{
+				"type": "cluster_block_exception",
+				"reason": "index [test] blocked by: [FORBIDDEN/8/index read (api)];"
+			}],
+			"type": "cluster_block_exception",
+			"reason": "index [test] blocked by: [FORBIDDEN/8/index read (api)];"
+		},
+		"status": 403
+	}`)
+
+	testURL := "/test/_doc/objId?preference=_local&realtime=true"
+	s.mockAPIHandler.
+		On("Handle", "GET", testURL, mock.Anything).
+		Return(httpmock.Response{
+			Status: 403,
+			Body:   testBlocked,
+		}).
+		Once()
+
+	dst := struct{}{}
+
+	result, err := idx.Get(s.ctx, "objId", &dst)
+	s.ErrorIs(err, ErrReadBlocked)
+	s.False(result)
+	s.Contains(err.Error(), "index [test] blocked by")
+
+	s.mockAPIHandler.AssertExpectations(s.T())
+}
+
+func (s *IndexTestSuite) TestGetNonPointer() {
+	idx := New(s.mockClient, &Config{Name: "test"})
+
+	type testType struct {
+		Field1 string `json:"field1"`
+	}
+
+	result, err := idx.Get(s.ctx, "objId", testType{}, "field1")
+	s.ErrorIs(err, ErrNonPointerDestination)
+	s.False(result)
+
+	result, err = idx.Get(s.ctx, "objId", (*testType)(nil), "field1")
+	s.ErrorIs(err, ErrNonPointerDestination)
+	s.False(result)
+
+	// No request should have been made.
+	s.mockAPIHandler.AssertNotCalled(s.T(), "Handle", "GET", mock.Anything, mock.Anything)
+}
+
+func (s *IndexTestSuite) TestBodySnippet() {
+	s.Equal(`"short"`, bodySnippet([]byte("short")))
+
+	long := make([]byte, 2*maxSnippetLength)
+	for i := range long {
+		long[i] = 'a'
+	}
+
+	snippet := bodySnippet(long)
+	s.Equal(maxSnippetLength+len(`""...`), len(snippet))
+}
+
 func (s *IndexTestSuite) TestClose() {
 
 }
@@ -2,12 +2,14 @@ package config
 
 // ElasticSearch holds configuration for ElasticSearch.
 type ElasticSearch struct {
-	URL string `yaml:"url" env:"ELASTICSEARCH_URL"`
+	URL                string `yaml:"url" env:"ELASTICSEARCH_URL"`
+	RequestCompression string `yaml:"request_compression" env:"ELASTICSEARCH_REQUEST_COMPRESSION"` // Either "none" or "gzip".
 }
 
 // ElasticSearchDefaults returns the defaults for ElasticSearch.
 func ElasticSearchDefaults() ElasticSearch {
 	return ElasticSearch{
-		URL: "http://localhost:9200",
+		URL:                "http://localhost:9200",
+		RequestCompression: "none",
 	}
 }